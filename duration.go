@@ -2,8 +2,6 @@ package iso8601
 
 import (
 	"errors"
-	"fmt"
-	"log"
 	"time"
 )
 
@@ -16,23 +14,9 @@ var (
 )
 
 // P(n)Y(n)M(n)DT(n)H(n)M(n)S.
-var (
-	defaultOption = duration{
-		from: time.Now,
-	}
-	units = map[string]func(from time.Time, v uint64, scale float64) uint64{
-		"M": month,
-		"Y": year,
-	}
-	dateUnit = sampleUnits{
-		"D": uint64(time.Hour * 24),
-	}
-	timeUnits = sampleUnits{
-		"S": uint64(time.Second),
-		"M": uint64(time.Minute),
-		"H": uint64(time.Hour),
-	}
-)
+var defaultOption = duration{
+	from: time.Now,
+}
 
 func From(from func() time.Time) Option {
 	return func(d *duration) {
@@ -40,124 +24,41 @@ func From(from func() time.Time) Option {
 	}
 }
 
+// Strict makes ParseDuration and ParsePeriod enforce the ISO 8601 duration
+// grammar: units must appear in the canonical order Y→M→W→D→H→M→S with no
+// repeats, "W" may not be mixed with Y/M/D, a fraction may only appear on the
+// smallest unit present, "T" may appear at most once, and "P" alone (with no
+// components at all) is rejected. By default (lax mode) these are all
+// permitted.
+func Strict() Option {
+	return func(d *duration) {
+		d.strict = true
+	}
+}
+
 type Option func(*duration)
 
+type duration struct {
+	from   func() time.Time
+	strict bool
+}
+
 // ParseDuration parses a duration string format P(n)Y(n)M(n)DT(n)H(n)M(n)S.
 // use iso8601.From(time) when using the month and year, by default time.Now().
+// It is a thin wrapper over ParsePeriod that immediately resolves the period
+// against the configured from time, collapsing Y/M/W/D into a fixed time.Duration.
 func ParseDuration(s string, opts ...Option) (time.Duration, error) {
 	option := defaultOption
 	for _, opt := range opts {
 		opt(&option)
 	}
 
-	orig := s
-	var d uint64
-	neg := false
-
-	// Consume [-+]?
-	if s != "" {
-		c := s[0]
-		if c == '-' || c == '+' {
-			neg = c == '-'
-			s = s[1:]
-		}
-	}
-
-	if s == "" {
-		return 0, fmt.Errorf("iso8601: empty %w %q", ErrInvalidDuration, orig)
+	p, err := ParsePeriod(s, opts...)
+	if err != nil {
+		return 0, err
 	}
 
-	if s[0] != 'P' {
-		return 0, fmt.Errorf("iso8601: format %w %q", ErrInvalidDuration, orig)
-	}
-
-	s = s[1:]
-	unit := option.unit
-
-	for s != "" {
-		var (
-			v, f  uint64      // integers before, after decimal point
-			scale float64 = 1 // value = v + f/scale
-		)
-
-		var err error
-
-		if s != "" && s[0] == 'T' {
-			s = s[1:]
-			unit = timeUnits.unit
-		}
-
-		// The next character must be [0-9.]
-		if !(s[0] == '.' || '0' <= s[0] && s[0] <= '9') {
-			return 0, fmt.Errorf("iso8601: next character %w %q", ErrInvalidDuration, orig)
-		}
-
-		// Consume [0-9]*
-		pl := len(s)
-		v, s, err = leadingInt(s)
-		if err != nil {
-			return 0, fmt.Errorf("iso8601: leadingInt %w %q", ErrInvalidDuration, orig)
-		}
-		pre := pl != len(s) // whether we consumed anything before a period
-
-		// Consume (\.[0-9]*)?
-		post := false
-		if s != "" && s[0] == '.' {
-			s = s[1:]
-			pl := len(s)
-			f, scale, s = leadingFraction(s)
-			post = pl != len(s)
-		}
-
-		if !pre && !post {
-			// no digits (e.g. ".s" or "-.s")
-			return 0, fmt.Errorf("iso8601: leadingFraction %w %q", ErrInvalidDuration, orig)
-		}
-
-		// Consume unit.
-		i := 0
-		for ; i < len(s); i++ {
-			c := s[i]
-			if c == '.' || '0' <= c && c <= '9' || c == 'T' {
-				break
-			}
-		}
-		if i == 0 {
-			return 0, fmt.Errorf("iso8601: %w %q", ErrMissingUnit, orig)
-		}
-		u := s[:i]
-		s = s[i:]
-
-		v, err = unit(u, v, 0)
-		if err != nil {
-			return 0, fmt.Errorf("iso8601: %w unit %q", err, orig)
-		}
-
-		if f > 0 {
-			r, err := unit(u, f, scale)
-			if err != nil {
-				return 0, fmt.Errorf("iso8601: %w fraction %q", err, orig)
-			}
-			log.Println(u, f, scale, r)
-
-			v += r
-		}
-
-		if d > 1<<63-v {
-			return 0, fmt.Errorf("iso8601: 1<<63 %w %q", ErrOverflow, orig)
-		}
-		d += v
-	}
-
-	if neg {
-		return -time.Duration(d), nil
-	}
-
-	if d > 1<<63-1 {
-		return 0, fmt.Errorf("iso8601: %w %q", ErrOverflow, orig)
-	}
-
-	return time.Duration(d), nil
+	return p.Duration(option.from()), nil
 }
 
 // leadingInt consumes the leading [0-9]* from s.
@@ -212,79 +113,60 @@ func leadingFraction(s string) (x uint64, scale float64, rem string) {
 	return x, scale, s[i:]
 }
 
-func month(from time.Time, v uint64, scale float64) uint64 {
-	if scale == 0 {
-		return uint64(from.AddDate(0, int(v), 0).Sub(from))
-	}
+// FormatOption configures FormatDuration.
+type FormatOption func(*formatOption)
 
-	return uint64(float64(v) * (float64(from.AddDate(0, 1, 0).Sub(from)) / scale))
+type formatOption struct {
+	weeks bool
 }
 
-func year(from time.Time, v uint64, scale float64) uint64 {
-	if scale == 0 {
-		return uint64(from.AddDate(int(v), 0, 0).Sub(from))
+// Weeks makes FormatDuration emit the "W" (week) designator, e.g. "P1W",
+// whenever the duration is an exact multiple of 7 days. Durations that
+// don't divide evenly into weeks fall back to the default D/H/M/S form.
+func Weeks() FormatOption {
+	return func(o *formatOption) {
+		o.weeks = true
 	}
-
-	return uint64(float64(v) * (float64(from.AddDate(1, 0, 0).Sub(from)) / scale))
 }
 
-type sampleUnits map[string]uint64
-
-func (s sampleUnits) unit(name string, v uint64, scale float64) (uint64, error) {
-	if unit, ok := s[name]; ok {
-		if scale != 0 {
-			v = uint64(float64(v) * (float64(unit) / scale))
-			if v > 1<<63 {
-				// overflow
-				return 0, fmt.Errorf("iso8601:%w", ErrOverflow)
-			}
-
-			return v, nil
-		}
-
-		if v > 1<<63/unit {
-			// overflow
-			return 0, fmt.Errorf("iso8601:%w", ErrOverflow)
-		}
-
-		return v * unit, nil
+// FormatDuration returns a string representing the duration in the form "P1Y2M3DT4H5M6S".
+// Leading zero units are omitted. The zero duration formats as PT0S.
+// Use iso8601.Weeks() to emit the "P1W" form for durations that are an exact multiple of 7 days.
+func FormatDuration(duration time.Duration, opts ...FormatOption) string {
+	if duration == 0 {
+		return "PT0S"
 	}
 
-	return 0, fmt.Errorf("iso8601:%w", ErrMissingUnit)
-}
-
-type duration struct {
-	from func() time.Time
-}
-
-func (d *duration) unit(name string, v uint64, scale float64) (uint64, error) {
-	if _, ok := dateUnit[name]; ok {
-		return dateUnit.unit(name, v, scale)
+	option := formatOption{}
+	for _, opt := range opts {
+		opt(&option)
 	}
 
-	if unit, ok := units[name]; ok {
-		from := d.from()
-		out := unit(from, v, scale)
-		if out > 1<<63 {
-			// overflow
-			return 0, ErrOverflow
-		}
+	if option.weeks {
+		const week = uint64(time.Hour * 24 * 7)
 
-		d.from = func() time.Time {
-			return from.Add(time.Duration(out))
+		u := uint64(duration)
+		neg := duration < 0
+		if neg {
+			u = -u
 		}
 
-		return out, nil
-	}
+		if u%week == 0 {
+			var buf [24]byte
+			w := len(buf)
+			w--
+			buf[w] = 'W'
+			w = fmtInt(buf[:w], u/week)
+			w--
+			buf[w] = 'P'
 
-	return 0, fmt.Errorf("%w %q", ErrMissingUnit, name)
-}
+			if neg {
+				w--
+				buf[w] = '-'
+			}
 
-// FormatDuration returns a string representing the duration in the form "P1Y2M3DT4H5M6S".
-// Leading zero units are omitted. The zero duration formats as PT0S.
-func FormatDuration(duration time.Duration) string {
-	if duration == 0 {
-		return "PT0S"
+			return string(buf[w:])
+		}
 	}
 
 	var buf [32]byte
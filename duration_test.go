@@ -48,6 +48,39 @@ func TestFormatDuration(t *testing.T) {
 	}
 }
 
+func TestFormatDurationWeeks(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		val    time.Duration
+		expect string
+	}{
+		"1 week": {
+			val:    time.Hour * 24 * 7,
+			expect: "P1W",
+		},
+		"52 weeks": {
+			val:    time.Hour * 24 * 7 * 52,
+			expect: "P52W",
+		},
+		"negative week": {
+			val:    -time.Hour * 24 * 7,
+			expect: "-P1W",
+		},
+		"not a whole week falls back": {
+			val:    time.Hour*24 + time.Hour,
+			expect: "P1DT1H",
+		},
+	}
+
+	for name, test := range cases {
+		result := iso8601.FormatDuration(test.val, iso8601.Weeks())
+		if result != test.expect {
+			t.Errorf("test:%v got:%v, expect:%v", name, result, test.expect)
+		}
+	}
+}
+
 func TestParseDuration(t *testing.T) {
 	t.Parallel()
 
@@ -112,6 +145,35 @@ func TestParseDuration(t *testing.T) {
 			parse:  "PT0.000000001S",
 			expect: time.Nanosecond,
 		},
+		"one week": {
+			parse:  "P1W",
+			expect: time.Hour * 24 * 7,
+		},
+		"52 weeks": {
+			parse:  "P52W",
+			expect: time.Hour * 24 * 7 * 52,
+		},
+		"negative week": {
+			parse:  "-P1W",
+			expect: -time.Hour * 24 * 7,
+		},
+		"two weeks three days": {
+			parse:  "P2W3D",
+			expect: time.Hour * 24 * 17,
+		},
+		"extended format": {
+			parse:  "P0000-00-01T00:00:00",
+			expect: time.Hour * 24,
+		},
+		"fractional year": {
+			parse:  "P0.5Y",
+			expect: parseDuration(t, "4380h"),
+			opts: []iso8601.Option{
+				iso8601.From(func() time.Time {
+					return parseTime(t, "2023-01-01T00:00:00Z")
+				}),
+			},
+		},
 	}
 
 	for name, test := range cases {
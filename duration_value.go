@@ -0,0 +1,111 @@
+package iso8601
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Duration wraps time.Duration so it can be marshaled to and from its ISO 8601
+// textual representation (e.g. "P1DT2H"), and scanned from or written to a
+// database column via database/sql.
+type Duration struct {
+	D      time.Duration
+	anchor time.Time
+}
+
+// WithAnchor returns a copy of d that resolves the Y/M (year/month) components
+// against t rather than time.Now() the next time d is unmarshaled, so that
+// calendar-dependent durations round-trip predictably.
+func (d Duration) WithAnchor(t time.Time) Duration {
+	d.anchor = t
+
+	return d
+}
+
+func (d Duration) from() func() time.Time {
+	if d.anchor.IsZero() {
+		return time.Now
+	}
+
+	anchor := d.anchor
+
+	return func() time.Time { return anchor }
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(FormatDuration(d.D)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(text []byte) error {
+	dur, err := ParseDuration(string(text), From(d.from()))
+	if err != nil {
+		return err
+	}
+
+	d.D = dur
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d Duration) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Duration) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	text, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return d.UnmarshalText([]byte(s))
+}
+
+// Scan implements database/sql.Scanner.
+func (d *Duration) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		d.D = 0
+
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	case time.Duration:
+		d.D = v
+
+		return nil
+	case int64:
+		d.D = time.Duration(v)
+
+		return nil
+	default:
+		return fmt.Errorf("iso8601: %w: unsupported Scan type %T", ErrInvalidDuration, src)
+	}
+}
+
+// Value implements database/sql/driver.Valuer.
+func (d Duration) Value() (driver.Value, error) {
+	return string(FormatDuration(d.D)), nil
+}
@@ -0,0 +1,96 @@
+package iso8601_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gitoa.ru/go-4devs/iso8601"
+)
+
+func TestDurationTextRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	d := iso8601.Duration{D: time.Hour*24 + time.Hour}
+
+	text, err := d.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(text) != "P1DT1H" {
+		t.Errorf("got:%s expect:%s", text, "P1DT1H")
+	}
+
+	var got iso8601.Duration
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.D != d.D {
+		t.Errorf("got:%v expect:%v", got.D, d.D)
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	t.Parallel()
+
+	d := iso8601.Duration{D: time.Hour}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(data) != `"PT1H"` {
+		t.Errorf("got:%s expect:%s", data, `"PT1H"`)
+	}
+
+	var got iso8601.Duration
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.D != d.D {
+		t.Errorf("got:%v expect:%v", got.D, d.D)
+	}
+}
+
+func TestDurationScanValue(t *testing.T) {
+	t.Parallel()
+
+	var d iso8601.Duration
+	if err := d.Scan("P1D"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d.D != time.Hour*24 {
+		t.Errorf("got:%v expect:%v", d.D, time.Hour*24)
+	}
+
+	value, err := d.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value != "P1D" {
+		t.Errorf("got:%v expect:%v", value, "P1D")
+	}
+}
+
+func TestDurationWithAnchor(t *testing.T) {
+	t.Parallel()
+
+	anchor := parseTime(t, "2006-01-02T15:04:05Z")
+
+	d := iso8601.Duration{}.WithAnchor(anchor)
+
+	if err := d.UnmarshalText([]byte("P1Y")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expect := anchor.AddDate(1, 0, 0).Sub(anchor)
+	if d.D != expect {
+		t.Errorf("got:%v expect:%v", d.D, expect)
+	}
+}
@@ -0,0 +1,204 @@
+package iso8601
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidInterval          = errors.New("invalid interval")
+	ErrInvalidRepeatingInterval = errors.New("invalid repeating interval")
+)
+
+// Interval represents an ISO 8601 time interval with a concrete start and end.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ParseInterval parses an ISO 8601 time interval in one of its four forms:
+// "<start>/<end>", "<start>/<duration>", "<duration>/<end>" and "<duration>"
+// (relative to the time supplied via iso8601.From, by default time.Now()).
+// Start and end are parsed with time.Parse(time.RFC3339, ...) and durations
+// are parsed with ParseDuration, so months and years are resolved relative
+// to the interval's start (or end, for the "<duration>/<end>" form).
+func ParseInterval(s string, opts ...Option) (Interval, error) {
+	option := defaultOption
+	for _, opt := range opts {
+		opt(&option)
+	}
+
+	parts := strings.SplitN(s, "/", 2)
+
+	if len(parts) == 1 {
+		from := option.from()
+
+		d, err := ParseDuration(parts[0], withFrom(opts, func() time.Time { return from })...)
+		if err != nil {
+			return Interval{}, fmt.Errorf("iso8601: %w %q", err, s)
+		}
+
+		return Interval{Start: from, End: from.Add(d)}, nil
+	}
+
+	left, right := parts[0], parts[1]
+
+	switch {
+	case isDuration(left) && isDuration(right):
+		return Interval{}, fmt.Errorf("iso8601: %w %q", ErrInvalidInterval, s)
+	case isDuration(left):
+		end, err := time.Parse(time.RFC3339, right)
+		if err != nil {
+			return Interval{}, fmt.Errorf("iso8601: %w %q", ErrInvalidInterval, s)
+		}
+
+		d, err := ParseDuration(left, withFrom(opts, func() time.Time { return end })...)
+		if err != nil {
+			return Interval{}, fmt.Errorf("iso8601: %w %q", err, s)
+		}
+
+		return Interval{Start: end.Add(-d), End: end}, nil
+	case isDuration(right):
+		start, err := time.Parse(time.RFC3339, left)
+		if err != nil {
+			return Interval{}, fmt.Errorf("iso8601: %w %q", ErrInvalidInterval, s)
+		}
+
+		d, err := ParseDuration(right, withFrom(opts, func() time.Time { return start })...)
+		if err != nil {
+			return Interval{}, fmt.Errorf("iso8601: %w %q", err, s)
+		}
+
+		return Interval{Start: start, End: start.Add(d)}, nil
+	default:
+		start, err := time.Parse(time.RFC3339, left)
+		if err != nil {
+			return Interval{}, fmt.Errorf("iso8601: %w %q", ErrInvalidInterval, s)
+		}
+
+		end, err := time.Parse(time.RFC3339, right)
+		if err != nil {
+			return Interval{}, fmt.Errorf("iso8601: %w %q", ErrInvalidInterval, s)
+		}
+
+		return Interval{Start: start, End: end}, nil
+	}
+}
+
+// FormatInterval returns the "<start>/<end>" representation of i, formatting
+// both endpoints with time.RFC3339.
+func FormatInterval(i Interval) string {
+	return i.Start.Format(time.RFC3339) + "/" + i.End.Format(time.RFC3339)
+}
+
+// withFrom returns opts with a From(from) override appended, so that callers
+// forwarding opts to ParseDuration for a specific anchor don't drop the
+// caller's other options (e.g. Strict()) in the process.
+func withFrom(opts []Option, from func() time.Time) []Option {
+	return append(append([]Option{}, opts...), From(from))
+}
+
+func isDuration(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	s = strings.TrimPrefix(s, "+")
+
+	return strings.HasPrefix(s, "P")
+}
+
+// RepeatingInterval represents an ISO 8601 repeating interval, "Rn/<interval>"
+// or "R/<interval>" for an unbounded number of repetitions.
+type RepeatingInterval struct {
+	interval Interval
+	period   Period
+	n        int // remaining repetitions, -1 means unbounded
+}
+
+// ParseRepeatingInterval parses a repeating interval of the form "Rn/<interval>"
+// or "R/<interval>", where <interval> is any form accepted by ParseInterval.
+func ParseRepeatingInterval(s string, opts ...Option) (*RepeatingInterval, error) {
+	if !strings.HasPrefix(s, "R") {
+		return nil, fmt.Errorf("iso8601: %w %q", ErrInvalidRepeatingInterval, s)
+	}
+
+	rest := s[1:]
+
+	i := strings.IndexByte(rest, '/')
+	if i < 0 {
+		return nil, fmt.Errorf("iso8601: %w %q", ErrInvalidRepeatingInterval, s)
+	}
+
+	n := -1
+
+	if countPart := rest[:i]; countPart != "" {
+		parsed, err := strconv.Atoi(countPart)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("iso8601: %w %q", ErrInvalidRepeatingInterval, s)
+		}
+
+		n = parsed
+	}
+
+	intervalStr := rest[i+1:]
+
+	interval, err := ParseInterval(intervalStr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("iso8601: %w %q", err, s)
+	}
+
+	period, err := intervalPeriod(intervalStr, interval, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("iso8601: %w %q", err, s)
+	}
+
+	return &RepeatingInterval{
+		interval: interval,
+		period:   period,
+		n:        n,
+	}, nil
+}
+
+// intervalPeriod returns the Period to re-apply to the end of each repetition
+// of interval to compute the next one. When s carries an explicit duration
+// component ("<start>/<duration>" or "<duration>/<end>"), that component is
+// re-parsed as a Period so that Next can re-resolve months/years against each
+// new anchor in turn, rather than reusing a flat time.Duration computed once
+// that would drift across months of different lengths. When s is a plain
+// "<start>/<end>" pair with no duration designator, there is no calendar
+// component to preserve, so the fixed gap between start and end is used.
+func intervalPeriod(s string, interval Interval, opts ...Option) (Period, error) {
+	parts := strings.SplitN(s, "/", 2)
+
+	switch {
+	case len(parts) == 1:
+		return ParsePeriod(parts[0], opts...)
+	case isDuration(parts[0]):
+		return ParsePeriod(parts[0], opts...)
+	case isDuration(parts[1]):
+		return ParsePeriod(parts[1], opts...)
+	default:
+		return Period{Seconds: interval.End.Sub(interval.Start).Seconds()}, nil
+	}
+}
+
+// Next returns the next start/end pair of the repeating interval. ok is false
+// once an n-bounded repeating interval has been exhausted; an unbounded
+// repeating interval ("R/<interval>") never returns ok == false. Each call
+// re-resolves the period against the new start, so month/year components
+// honor the anchor date every repetition instead of a fixed time.Duration.
+func (r *RepeatingInterval) Next() (start, end time.Time, ok bool) {
+	if r.n == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+
+	if r.n > 0 {
+		r.n--
+	}
+
+	start, end = r.interval.Start, r.interval.End
+	r.interval = Interval{Start: end, End: r.period.AddTo(end)}
+
+	return start, end, true
+}
@@ -0,0 +1,194 @@
+package iso8601_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gitoa.ru/go-4devs/iso8601"
+)
+
+func TestParseInterval(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		parse string
+		start string
+		end   string
+	}{
+		"start/end": {
+			parse: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z",
+			start: "2007-03-01T13:00:00Z",
+			end:   "2008-05-11T15:30:00Z",
+		},
+		"start/duration": {
+			parse: "2007-03-01T13:00:00Z/P1Y2M10DT2H30M",
+			start: "2007-03-01T13:00:00Z",
+			end:   "2008-05-11T15:30:00Z",
+		},
+		"duration/end": {
+			parse: "P1D/2008-05-11T15:30:00Z",
+			start: "2008-05-10T15:30:00Z",
+			end:   "2008-05-11T15:30:00Z",
+		},
+	}
+
+	for name, test := range cases {
+		interval, err := iso8601.ParseInterval(test.parse)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		start := parseTime(t, test.start)
+		end := parseTime(t, test.end)
+
+		if !interval.Start.Equal(start) {
+			t.Errorf("%s: start got:%v expect:%v", name, interval.Start, start)
+		}
+
+		if !interval.End.Equal(end) {
+			t.Errorf("%s: end got:%v expect:%v", name, interval.End, end)
+		}
+	}
+}
+
+func TestParseIntervalDurationOnly(t *testing.T) {
+	t.Parallel()
+
+	from := parseTime(t, "2020-01-01T00:00:00Z")
+
+	interval, err := iso8601.ParseInterval("P1D", iso8601.From(func() time.Time { return from }))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !interval.Start.Equal(from) {
+		t.Errorf("start got:%v expect:%v", interval.Start, from)
+	}
+
+	expectEnd := from.Add(time.Hour * 24)
+	if !interval.End.Equal(expectEnd) {
+		t.Errorf("end got:%v expect:%v", interval.End, expectEnd)
+	}
+}
+
+func TestParseIntervalForwardsOptsToDuration(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"duration only":  "P1M1Y",
+		"start/duration": "2020-01-01T00:00:00Z/P1M1Y",
+		"duration/end":   "P1M1Y/2020-01-01T00:00:00Z",
+	}
+
+	for name, parse := range cases {
+		if _, err := iso8601.ParseInterval(parse, iso8601.Strict()); !errors.Is(err, iso8601.ErrInvalidDuration) {
+			t.Errorf("%s: got:%v expect:%v", name, err, iso8601.ErrInvalidDuration)
+		}
+	}
+}
+
+func TestFormatInterval(t *testing.T) {
+	t.Parallel()
+
+	interval := iso8601.Interval{
+		Start: parseTime(t, "2007-03-01T13:00:00Z"),
+		End:   parseTime(t, "2008-05-11T15:30:00Z"),
+	}
+
+	expect := "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z"
+	if result := iso8601.FormatInterval(interval); result != expect {
+		t.Errorf("got:%v expect:%v", result, expect)
+	}
+}
+
+func TestParseRepeatingInterval(t *testing.T) {
+	t.Parallel()
+
+	repeating, err := iso8601.ParseRepeatingInterval("R3/2008-03-01T13:00:00Z/P1D")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []time.Time
+
+	for {
+		start, _, ok := repeating.Next()
+		if !ok {
+			break
+		}
+
+		got = append(got, start)
+	}
+
+	expect := []time.Time{
+		parseTime(t, "2008-03-01T13:00:00Z"),
+		parseTime(t, "2008-03-02T13:00:00Z"),
+		parseTime(t, "2008-03-03T13:00:00Z"),
+	}
+
+	if len(got) != len(expect) {
+		t.Fatalf("got:%d starts expect:%d", len(got), len(expect))
+	}
+
+	for i, start := range got {
+		if !start.Equal(expect[i]) {
+			t.Errorf("%d: got:%v expect:%v", i, start, expect[i])
+		}
+	}
+}
+
+func TestParseRepeatingIntervalMonthlyClampsAtMonthEnd(t *testing.T) {
+	t.Parallel()
+
+	// Jan 31 + P1M clamps to Feb 29 (2008 is a leap year) rather than
+	// overflowing into March; each following repeat re-anchors from that
+	// clamped end, so the sequence stays near month-end instead of drifting
+	// onto a fixed day-of-month.
+	repeating, err := iso8601.ParseRepeatingInterval("R3/2008-01-31T00:00:00Z/P1M")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []time.Time
+
+	for {
+		_, end, ok := repeating.Next()
+		if !ok {
+			break
+		}
+
+		got = append(got, end)
+	}
+
+	expect := []time.Time{
+		parseTime(t, "2008-02-29T00:00:00Z"),
+		parseTime(t, "2008-03-29T00:00:00Z"),
+		parseTime(t, "2008-04-29T00:00:00Z"),
+	}
+
+	if len(got) != len(expect) {
+		t.Fatalf("got:%d ends expect:%d", len(got), len(expect))
+	}
+
+	for i, end := range got {
+		if !end.Equal(expect[i]) {
+			t.Errorf("%d: got:%v expect:%v", i, end, expect[i])
+		}
+	}
+}
+
+func TestParseRepeatingIntervalUnbounded(t *testing.T) {
+	t.Parallel()
+
+	repeating, err := iso8601.ParseRepeatingInterval("R/2008-03-01T13:00:00Z/P1D")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, _, ok := repeating.Next(); !ok {
+			t.Fatalf("expected unbounded repeating interval to keep yielding, stopped at %d", i)
+		}
+	}
+}
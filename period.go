@@ -0,0 +1,469 @@
+package iso8601
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period holds the raw Y/M/W/D/H/M/S components of an ISO 8601 duration,
+// unlike ParseDuration/time.Duration it does not collapse them into a fixed
+// number of nanoseconds, so calendar semantics (e.g. "P1M" meaning "the same
+// day next month") are preserved until it is applied to a concrete time.Time.
+type Period struct {
+	Neg     bool
+	Years   float64
+	Months  float64
+	Weeks   float64
+	Days    float64
+	Hours   float64
+	Minutes float64
+	Seconds float64
+}
+
+// ParsePeriod parses a duration string of the form P(n)Y(n)M(n)W(n)DT(n)H(n)M(n)S
+// into its raw components, without resolving Y/M/W/D against a concrete time.
+// By default the grammar is applied laxly (mixed/repeated/out-of-order units,
+// fractions on non-terminal units); pass iso8601.Strict() to reject those.
+func ParsePeriod(s string, opts ...Option) (Period, error) {
+	var option duration
+	for _, opt := range opts {
+		opt(&option)
+	}
+
+	orig := s
+
+	var p Period
+
+	// Consume [-+]?
+	if s != "" {
+		c := s[0]
+		if c == '-' || c == '+' {
+			p.Neg = c == '-'
+			s = s[1:]
+		}
+	}
+
+	if s == "" {
+		return Period{}, fmt.Errorf("iso8601: empty %w %q", ErrInvalidDuration, orig)
+	}
+
+	if s[0] != 'P' {
+		return Period{}, fmt.Errorf("iso8601: format %w %q", ErrInvalidDuration, orig)
+	}
+
+	s = s[1:]
+	afterP := s
+
+	if isExtendedPeriod(s) {
+		ext, err := parseExtendedPeriod(s, orig)
+		if err != nil {
+			return Period{}, err
+		}
+
+		ext.Neg = p.Neg
+
+		return ext, nil
+	}
+
+	if option.strict && afterP == "" {
+		return Period{}, fmt.Errorf("iso8601: empty %w %q", ErrInvalidDuration, orig)
+	}
+
+	inTime := false
+	lastRank := -1
+	sawFraction := false
+	sawWeek := false
+	sawOtherDateUnit := false
+
+	for s != "" {
+		var (
+			v, f  uint64      // integers before, after decimal point
+			scale float64 = 1 // value = v + f/scale
+		)
+
+		var err error
+
+		if s[0] == 'T' {
+			if option.strict && inTime {
+				return Period{}, fmt.Errorf("iso8601: repeated T %w %q", ErrInvalidDuration, orig)
+			}
+
+			s = s[1:]
+			inTime = true
+
+			if s == "" {
+				return Period{}, fmt.Errorf("iso8601: next character %w %q", ErrInvalidDuration, orig)
+			}
+		}
+
+		// The next character must be [0-9.]
+		if !(s[0] == '.' || '0' <= s[0] && s[0] <= '9') {
+			return Period{}, fmt.Errorf("iso8601: next character %w %q", ErrInvalidDuration, orig)
+		}
+
+		// Consume [0-9]*
+		pl := len(s)
+		v, s, err = leadingInt(s)
+		if err != nil {
+			return Period{}, fmt.Errorf("iso8601: leadingInt %w %q", ErrInvalidDuration, orig)
+		}
+		pre := pl != len(s) // whether we consumed anything before a period
+
+		// Consume (\.[0-9]*)?
+		post := false
+		if s != "" && s[0] == '.' {
+			s = s[1:]
+			pl := len(s)
+			f, scale, s = leadingFraction(s)
+			post = pl != len(s)
+		}
+
+		if !pre && !post {
+			// no digits (e.g. ".s" or "-.s")
+			return Period{}, fmt.Errorf("iso8601: leadingFraction %w %q", ErrInvalidDuration, orig)
+		}
+
+		// Consume unit.
+		i := 0
+		for ; i < len(s); i++ {
+			c := s[i]
+			if c == '.' || '0' <= c && c <= '9' || c == 'T' {
+				break
+			}
+		}
+
+		if i == 0 {
+			return Period{}, fmt.Errorf("iso8601: %w %q", ErrMissingUnit, orig)
+		}
+
+		u := s[:i]
+		s = s[i:]
+
+		if option.strict {
+			rank := periodUnitRank(inTime, u)
+			if rank <= lastRank {
+				return Period{}, fmt.Errorf("iso8601: out of order %w %q", ErrInvalidDuration, orig)
+			}
+
+			lastRank = rank
+
+			if sawFraction {
+				return Period{}, fmt.Errorf("iso8601: fraction not on smallest unit %w %q", ErrInvalidDuration, orig)
+			}
+
+			if !inTime && u == "W" {
+				sawWeek = true
+			} else if !inTime {
+				sawOtherDateUnit = true
+			}
+
+			if sawWeek && sawOtherDateUnit {
+				return Period{}, fmt.Errorf("iso8601: week mixed with Y/M/D %w %q", ErrInvalidDuration, orig)
+			}
+		}
+
+		value := float64(v)
+		if f > 0 {
+			value += float64(f) / scale
+			sawFraction = true
+		}
+
+		if err := p.set(inTime, u, value); err != nil {
+			return Period{}, fmt.Errorf("iso8601: %w unit %q", err, orig)
+		}
+	}
+
+	return p, nil
+}
+
+// periodUnitRank returns the canonical Y→M→W→D→H→M→S position of unit,
+// distinguishing the date-side "M" (month) from the time-side "M" (minute)
+// via inTime. Used only by Strict() to reject out-of-order or repeated units.
+func periodUnitRank(inTime bool, unit string) int {
+	if !inTime {
+		switch unit {
+		case "Y":
+			return 0
+		case "M":
+			return 1
+		case "W":
+			return 2
+		case "D":
+			return 3
+		}
+	} else {
+		switch unit {
+		case "H":
+			return 4
+		case "M":
+			return 5
+		case "S":
+			return 6
+		}
+	}
+
+	return -1
+}
+
+func (p *Period) set(inTime bool, unit string, value float64) error {
+	if inTime {
+		switch unit {
+		case "H":
+			p.Hours = value
+		case "M":
+			p.Minutes = value
+		case "S":
+			p.Seconds = value
+		default:
+			return fmt.Errorf("%w %q", ErrMissingUnit, unit)
+		}
+
+		return nil
+	}
+
+	switch unit {
+	case "Y":
+		p.Years = value
+	case "M":
+		p.Months = value
+	case "W":
+		p.Weeks = value
+	case "D":
+		p.Days = value
+	default:
+		return fmt.Errorf("%w %q", ErrMissingUnit, unit)
+	}
+
+	return nil
+}
+
+// AddTo adds the period to t. Years and months are added calendar-aware,
+// clamping the day-of-month to the last valid day of the target month
+// (e.g. "P1M" added to Jan 31 lands on Feb 28, or Feb 29 in a leap year,
+// rather than overflowing into March), matching the behaviour of plusMonths
+// in most calendar libraries. Whole weeks/days are then added with t.AddDate,
+// and hours/minutes/seconds as a flat duration via t.Add. Fractional Y/M/W/D values (e.g. "P0.5Y")
+// are resolved by adding the integer part first and then scaling the
+// clamped duration of one more such unit, anchored at the result, by the
+// fractional remainder.
+func (p Period) AddTo(t time.Time) time.Time {
+	sign := 1.0
+	if p.Neg {
+		sign = -1
+	}
+
+	years, yearFrac := math.Modf(sign * p.Years)
+	months, monthFrac := math.Modf(sign * p.Months)
+	days, dayFrac := math.Modf(sign * (p.Weeks*7 + p.Days))
+
+	whole := addMonthsClamped(t, int(years)*12+int(months))
+	whole = whole.AddDate(0, 0, int(days))
+
+	t = whole
+	if yearFrac != 0 {
+		t = t.Add(time.Duration(yearFrac * float64(addMonthsClamped(whole, 12).Sub(whole))))
+	}
+
+	if monthFrac != 0 {
+		t = t.Add(time.Duration(monthFrac * float64(addMonthsClamped(whole, 1).Sub(whole))))
+	}
+
+	if dayFrac != 0 {
+		t = t.Add(time.Duration(dayFrac * float64(24*time.Hour)))
+	}
+
+	seconds := sign * (p.Hours*3600 + p.Minutes*60 + p.Seconds)
+
+	return t.Add(time.Duration(seconds * float64(time.Second)))
+}
+
+// addMonthsClamped adds months to t's year/month, clamping the day-of-month
+// to the last valid day of the resulting month instead of letting it
+// overflow into the following month (the behaviour of time.Time.AddDate).
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+
+	total := int(month) - 1 + months
+	y := year + total/12
+	m := total % 12
+
+	if m < 0 {
+		m += 12
+		y--
+	}
+
+	targetMonth := time.Month(m + 1)
+	if last := lastDayOfMonth(y, targetMonth, t.Location()); day > last {
+		day = last
+	}
+
+	return time.Date(y, targetMonth, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// lastDayOfMonth returns the number of days in month of year, in loc.
+func lastDayOfMonth(year int, month time.Month, loc *time.Location) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+}
+
+// Sub subtracts the period from t.
+func (p Period) Sub(t time.Time) time.Time {
+	neg := p
+	neg.Neg = !neg.Neg
+
+	return neg.AddTo(t)
+}
+
+// Duration returns the period flattened to a time.Duration, resolving Y/M/W/D
+// against from. It is p.AddTo(from).Sub(from), so year and month components
+// are clamped at month end exactly as AddTo clamps them.
+func (p Period) Duration(from time.Time) time.Duration {
+	return p.AddTo(from).Sub(from)
+}
+
+// String returns the ISO 8601 representation of p, e.g. "P1Y2M3DT4H5M6S".
+// The zero Period formats as "PT0S".
+func (p Period) String() string {
+	if p == (Period{}) {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+
+	if p.Neg {
+		b.WriteByte('-')
+	}
+
+	b.WriteByte('P')
+	writePeriodPart(&b, p.Years, 'Y')
+	writePeriodPart(&b, p.Months, 'M')
+	writePeriodPart(&b, p.Weeks, 'W')
+	writePeriodPart(&b, p.Days, 'D')
+
+	if p.Hours != 0 || p.Minutes != 0 || p.Seconds != 0 {
+		b.WriteByte('T')
+		writePeriodPart(&b, p.Hours, 'H')
+		writePeriodPart(&b, p.Minutes, 'M')
+		writePeriodPart(&b, p.Seconds, 'S')
+	}
+
+	return b.String()
+}
+
+func writePeriodPart(b *strings.Builder, v float64, unit byte) {
+	if v == 0 {
+		return
+	}
+
+	b.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	b.WriteByte(unit)
+}
+
+// isExtendedPeriod reports whether s (the part of a duration string after the
+// leading "P") uses the ISO 8601 alternative format "YYYY-MM-DD[Thh:mm:ss]"
+// rather than the "(n)Y(n)M...(n)S" designator form: it is recognised by a
+// four-digit year immediately followed by "-".
+func isExtendedPeriod(s string) bool {
+	return len(s) >= 5 && isDigits(s[:4]) && s[4] == '-'
+}
+
+// parseExtendedPeriod parses the "YYYY-MM-DD[Thh:mm:ss]" alternative duration
+// format. orig is the original, unconsumed input, used for error messages.
+func parseExtendedPeriod(s, orig string) (Period, error) {
+	if len(s) < 10 || s[4] != '-' || s[7] != '-' {
+		return Period{}, fmt.Errorf("iso8601: extended %w %q", ErrInvalidDuration, orig)
+	}
+
+	year, err := parseFixedWidth(s[0:4], orig)
+	if err != nil {
+		return Period{}, err
+	}
+
+	month, err := parseFixedWidth(s[5:7], orig)
+	if err != nil {
+		return Period{}, err
+	}
+
+	day, err := parseFixedWidth(s[8:10], orig)
+	if err != nil {
+		return Period{}, err
+	}
+
+	p := Period{Years: float64(year), Months: float64(month), Days: float64(day)}
+
+	rest := s[10:]
+	if rest == "" {
+		return p, nil
+	}
+
+	if rest[0] != 'T' || len(rest) != 9 || rest[3] != ':' || rest[6] != ':' {
+		return Period{}, fmt.Errorf("iso8601: extended %w %q", ErrInvalidDuration, orig)
+	}
+
+	hour, err := parseFixedWidth(rest[1:3], orig)
+	if err != nil {
+		return Period{}, err
+	}
+
+	minute, err := parseFixedWidth(rest[4:6], orig)
+	if err != nil {
+		return Period{}, err
+	}
+
+	second, err := parseFixedWidth(rest[7:9], orig)
+	if err != nil {
+		return Period{}, err
+	}
+
+	p.Hours, p.Minutes, p.Seconds = float64(hour), float64(minute), float64(second)
+
+	return p, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseFixedWidth parses a fixed-width, zero-padded non-negative integer
+// field of the extended duration format (e.g. "0003", "06"). orig is the
+// original, unconsumed input, used for error messages.
+func parseFixedWidth(s, orig string) (int, error) {
+	if !isDigits(s) {
+		return 0, fmt.Errorf("iso8601: extended %w %q", ErrInvalidDuration, orig)
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("iso8601: extended %w %q", ErrInvalidDuration, orig)
+	}
+
+	return n, nil
+}
+
+// StringExtended returns the ISO 8601 alternative representation of p,
+// "P[YYYY]-[MM]-[DD]T[hh]:[mm]:[ss]", omitting the "T" section when the
+// time-of-day components are all zero. Weeks are folded into days, since the
+// alternative format has no week designator.
+func (p Period) StringExtended() string {
+	var b strings.Builder
+
+	if p.Neg {
+		b.WriteByte('-')
+	}
+
+	fmt.Fprintf(&b, "P%04d-%02d-%02d", int(p.Years), int(p.Months), int(p.Weeks*7+p.Days))
+
+	if p.Hours != 0 || p.Minutes != 0 || p.Seconds != 0 {
+		fmt.Fprintf(&b, "T%02d:%02d:%02d", int(p.Hours), int(p.Minutes), int(p.Seconds))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,216 @@
+package iso8601_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gitoa.ru/go-4devs/iso8601"
+)
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	p, err := iso8601.ParsePeriod("P3Y6M4DT12H30M17S")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expect := iso8601.Period{
+		Years: 3, Months: 6, Days: 4,
+		Hours: 12, Minutes: 30, Seconds: 17,
+	}
+
+	if p != expect {
+		t.Errorf("got:%+v expect:%+v", p, expect)
+	}
+}
+
+func TestPeriodAddTo(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		parse  string
+		from   string
+		expect string
+	}{
+		"P1M on Jan 31 clamps to Feb 29 (leap year)": {
+			parse:  "P1M",
+			from:   "2024-01-31T00:00:00Z",
+			expect: "2024-02-29T00:00:00Z",
+		},
+		"P1Y leap day clamps to Feb 28 (non-leap target year)": {
+			parse:  "P1Y",
+			from:   "2024-02-29T00:00:00Z",
+			expect: "2025-02-28T00:00:00Z",
+		},
+		"fractional year": {
+			parse:  "P0.5Y",
+			from:   "2023-01-01T00:00:00Z",
+			expect: "2023-07-02T12:00:00Z",
+		},
+	}
+
+	for name, test := range cases {
+		p, err := iso8601.ParsePeriod(test.parse)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		from := parseTime(t, test.from)
+		got := p.AddTo(from)
+		expect := parseTime(t, test.expect)
+
+		if !got.Equal(expect) {
+			t.Errorf("%s: got:%v expect:%v", name, got, expect)
+		}
+	}
+}
+
+func TestPeriodSub(t *testing.T) {
+	t.Parallel()
+
+	p, err := iso8601.ParsePeriod("P1D")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := parseTime(t, "2020-01-02T00:00:00Z")
+	got := p.Sub(from)
+	expect := parseTime(t, "2020-01-01T00:00:00Z")
+
+	if !got.Equal(expect) {
+		t.Errorf("got:%v expect:%v", got, expect)
+	}
+}
+
+func TestPeriodDuration(t *testing.T) {
+	t.Parallel()
+
+	p, err := iso8601.ParsePeriod("P1D")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := parseTime(t, "2020-01-01T00:00:00Z")
+	if got := p.Duration(from); got != time.Hour*24 {
+		t.Errorf("got:%v expect:%v", got, time.Hour*24)
+	}
+}
+
+func TestParsePeriodExtended(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		parse  string
+		expect iso8601.Period
+	}{
+		"full": {
+			parse:  "P0003-06-04T12:30:17",
+			expect: iso8601.Period{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 17},
+		},
+		"date only": {
+			parse:  "P0001-00-00",
+			expect: iso8601.Period{Years: 1},
+		},
+		"negative": {
+			parse:  "-P0000-01-00",
+			expect: iso8601.Period{Neg: true, Months: 1},
+		},
+	}
+
+	for name, test := range cases {
+		p, err := iso8601.ParsePeriod(test.parse)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if p != test.expect {
+			t.Errorf("%s: got:%+v expect:%+v", name, p, test.expect)
+		}
+	}
+}
+
+func TestParsePeriodExtendedInvalid(t *testing.T) {
+	t.Parallel()
+
+	cases := []string{
+		"P0003-6-04",
+		"P0003-06-04Tabc",
+		"P0003-06-04T12:30",
+	}
+
+	for _, parse := range cases {
+		if _, err := iso8601.ParsePeriod(parse); !errors.Is(err, iso8601.ErrInvalidDuration) {
+			t.Errorf("%s: got:%v expect:%v", parse, err, iso8601.ErrInvalidDuration)
+		}
+	}
+}
+
+func TestPeriodStringExtended(t *testing.T) {
+	t.Parallel()
+
+	p := iso8601.Period{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 17}
+
+	expect := "P0003-06-04T12:30:17"
+	if result := p.StringExtended(); result != expect {
+		t.Errorf("got:%v expect:%v", result, expect)
+	}
+}
+
+func TestParsePeriodStrict(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"bare P":                   "P",
+		"out of order":             "P1M1Y",
+		"repeated unit":            "P1Y1Y",
+		"fraction not on smallest": "PT1.5H30M",
+		"repeated T":               "PT1HT2H",
+		"week mixed with Y/M/D":    "P1Y2W3D",
+	}
+
+	for name, parse := range cases {
+		if _, err := iso8601.ParsePeriod(parse, iso8601.Strict()); !errors.Is(err, iso8601.ErrInvalidDuration) {
+			t.Errorf("%s: got:%v expect:%v", name, err, iso8601.ErrInvalidDuration)
+		}
+
+		// the same input is accepted in the default, lax mode.
+		if _, err := iso8601.ParsePeriod(parse); err != nil && name != "bare P" {
+			t.Errorf("%s: unexpected error in lax mode: %v", name, err)
+		}
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		parse  string
+		expect string
+	}{
+		"round trip": {
+			parse:  "P3Y6M4DT12H30M17S",
+			expect: "P3Y6M4DT12H30M17S",
+		},
+		"zero": {
+			parse:  "PT0S",
+			expect: "PT0S",
+		},
+		"negative": {
+			parse:  "-P1D",
+			expect: "-P1D",
+		},
+	}
+
+	for name, test := range cases {
+		p, err := iso8601.ParsePeriod(test.parse)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if result := p.String(); result != test.expect {
+			t.Errorf("%s: got:%v expect:%v", name, result, test.expect)
+		}
+	}
+}